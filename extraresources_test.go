@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	fnv1beta1 "github.com/crossplane/function-sdk-go/proto/v1beta1"
+
+	"github.com/crossplane-contrib/function-go-templating/input/v1beta1"
+)
+
+func mustStruct(t *testing.T, m map[string]interface{}) *structpb.Struct {
+	t.Helper()
+
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		t.Fatalf("structpb.NewStruct(...): unexpected error: %v", err)
+	}
+
+	return s
+}
+
+func newObservedXR(t *testing.T) *structpb.Struct {
+	t.Helper()
+
+	return mustStruct(t, map[string]interface{}{
+		"apiVersion": "example.org/v1",
+		"kind":       "XR",
+		"metadata": map[string]interface{}{
+			"name": "test-xr",
+		},
+	})
+}
+
+func mustInlineInput(t *testing.T, template string) *structpb.Struct {
+	t.Helper()
+
+	return mustStruct(t, map[string]interface{}{
+		"apiVersion": "gotemplating.fn.crossplane.io/v1beta1",
+		"kind":       "GoTemplate",
+		"source":     string(v1beta1.InlineTemplateSourceType),
+		"inline": map[string]interface{}{
+			"template": template,
+		},
+	})
+}
+
+// TestRunFunction_ExtraResourcesRoundTrip simulates the two-pass invocation
+// Crossplane performs when a Function requests extra resources: once where
+// the Function asks for them, and once where they're supplied back.
+func TestRunFunction_ExtraResourcesRoundTrip(t *testing.T) {
+	f := &Function{log: logging.NewNopLogger()}
+
+	t.Run("RequestsExtraResources", func(t *testing.T) {
+		tmpl := `
+apiVersion: meta.gotemplating.fn.crossplane.io/v1alpha1
+kind: ExtraResources
+metadata:
+  name: extra-resources
+spec:
+  resources:
+  - name: vpc
+    apiVersion: ec2.aws.upbound.io/v1beta1
+    kind: VPC
+    matchLabels:
+      environment: prod
+`
+
+		req := &fnv1beta1.RunFunctionRequest{
+			Meta:  &fnv1beta1.RequestMeta{Tag: "requests-extra-resources"},
+			Input: mustInlineInput(t, tmpl),
+			Observed: &fnv1beta1.State{
+				Composite: &fnv1beta1.Resource{Resource: newObservedXR(t)},
+			},
+		}
+
+		rsp, err := f.RunFunction(context.Background(), req)
+		if err != nil {
+			t.Fatalf("RunFunction(...): unexpected error: %v", err)
+		}
+
+		sel := rsp.GetRequirements().GetExtraResources()["vpc"]
+		if sel == nil {
+			t.Fatalf("RunFunction(...): rsp.Requirements.ExtraResources[\"vpc\"] is nil")
+		}
+
+		if sel.GetApiVersion() != "ec2.aws.upbound.io/v1beta1" || sel.GetKind() != "VPC" {
+			t.Errorf("RunFunction(...): got selector %+v, want apiVersion=ec2.aws.upbound.io/v1beta1 kind=VPC", sel)
+		}
+
+		if got := sel.GetMatchLabels().GetLabels()["environment"]; got != "prod" {
+			t.Errorf("RunFunction(...): matchLabels[environment] = %q, want %q", got, "prod")
+		}
+
+		if got := rsp.GetDesired().GetResources(); len(got) != 0 {
+			t.Errorf("RunFunction(...): rsp.Desired.Resources has %d entries, want 0 (ExtraResources shouldn't be composed)", len(got))
+		}
+	})
+
+	t.Run("ConsumesSuppliedExtraResources", func(t *testing.T) {
+		tmpl := `
+{{- with index .extraResources "vpc" "items" }}
+apiVersion: ec2.aws.upbound.io/v1beta1
+kind: Subnet
+metadata:
+  name: subnet
+  annotations:
+    crossplane.io/composition-resource-name: subnet
+spec:
+  forProvider:
+    vpcId: {{ (index . 0).spec.forProvider.id }}
+{{- end }}
+`
+
+		req := &fnv1beta1.RunFunctionRequest{
+			Meta:  &fnv1beta1.RequestMeta{Tag: "consumes-supplied-extra-resources"},
+			Input: mustInlineInput(t, tmpl),
+			Observed: &fnv1beta1.State{
+				Composite: &fnv1beta1.Resource{Resource: newObservedXR(t)},
+			},
+			ExtraResources: map[string]*fnv1beta1.Resources{
+				"vpc": {
+					Items: []*fnv1beta1.Resource{{
+						Resource: mustStruct(t, map[string]interface{}{
+							"apiVersion": "ec2.aws.upbound.io/v1beta1",
+							"kind":       "VPC",
+							"metadata": map[string]interface{}{
+								"name": "prod-vpc",
+							},
+							"spec": map[string]interface{}{
+								"forProvider": map[string]interface{}{
+									"id": "vpc-123",
+								},
+							},
+						}),
+					}},
+				},
+			},
+		}
+
+		rsp, err := f.RunFunction(context.Background(), req)
+		if err != nil {
+			t.Fatalf("RunFunction(...): unexpected error: %v", err)
+		}
+
+		subnet := rsp.GetDesired().GetResources()["subnet"]
+		if subnet == nil {
+			t.Fatalf("RunFunction(...): rsp.Desired.Resources[\"subnet\"] is nil, template didn't see the supplied extra resource")
+		}
+
+		spec, _ := subnet.GetResource().AsMap()["spec"].(map[string]interface{})
+		forProvider, _ := spec["forProvider"].(map[string]interface{})
+		got := forProvider["vpcId"]
+
+		if got != "vpc-123" {
+			t.Errorf("rendered subnet vpcId = %v, want %q", got, "vpc-123")
+		}
+	})
+}
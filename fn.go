@@ -8,6 +8,7 @@ import (
 	"io"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -36,6 +37,7 @@ const (
 	errFmtInvalidFunction   = "invalid function input: %s"
 	errFmtInvalidReadyValue = "%s is invalid, ready annotation must be True, Unspecified, or False"
 	errFmtInvalidMetaType   = "invalid meta kind %s"
+	errFmtInvalidSeverity   = "%s is invalid, result severity must be Normal, Warning, or Fatal"
 
 	errCannotGet   = "cannot get the function input"
 	errCannotParse = "cannot parse the provided templates"
@@ -66,15 +68,15 @@ func (f *Function) RunFunction(_ context.Context, req *fnv1beta1.RunFunctionRequ
 		return rsp, nil
 	}
 
-	tmpl, err := GetNewTemplateWithFunctionMaps().Parse(tg.GetTemplates())
+	tmpl, err := tg.LoadInto(GetNewTemplateWithFunctionMaps())
 	if err != nil {
 		response.Fatal(rsp, errors.Wrap(err, fmt.Sprintf(errFmtInvalidFunction, errCannotParse)))
 		return rsp, nil
 	}
 
-	reqMap, err := convertToMap(req)
+	reqMap, err := buildTemplateContext(req)
 	if err != nil {
-		response.Fatal(rsp, errors.Wrap(err, "cannot convert request to map"))
+		response.Fatal(rsp, errors.Wrap(err, "cannot build template context"))
 		return rsp, nil
 	}
 
@@ -82,7 +84,7 @@ func (f *Function) RunFunction(_ context.Context, req *fnv1beta1.RunFunctionRequ
 
 	buf := &bytes.Buffer{}
 
-	if err := tmpl.Execute(buf, reqMap); err != nil {
+	if err := tmpl.ExecuteTemplate(buf, entrypointTemplateName, reqMap); err != nil {
 		response.Fatal(rsp, errors.Wrap(err, "cannot execute template"))
 		return rsp, nil
 	}
@@ -106,6 +108,11 @@ func (f *Function) RunFunction(_ context.Context, req *fnv1beta1.RunFunctionRequ
 		}
 	}
 
+	if err := validateRenderedObjects(rsp, in, objs); err != nil {
+		response.Fatal(rsp, errors.Wrap(err, "cannot validate rendered manifests"))
+		return rsp, nil
+	}
+
 	// Get the desired composite resource from the request.
 	dxr, err := request.GetDesiredCompositeResource(req)
 	if err != nil {
@@ -161,6 +168,25 @@ func (f *Function) RunFunction(_ context.Context, req *fnv1beta1.RunFunctionRequ
 					d, _ := base64.StdEncoding.DecodeString(v) //nolint:errcheck // k8s returns secret values encoded
 					dxr.ConnectionDetails[k] = d
 				}
+			case "Result":
+				fatal, err := setResult(rsp, obj)
+				if err != nil {
+					response.Fatal(rsp, errors.Wrapf(err, "cannot set result from %s", obj.GetName()))
+					return rsp, nil
+				}
+				if fatal {
+					return rsp, nil
+				}
+			case "ExtraResources":
+				if err := setExtraResourcesRequirement(rsp, obj); err != nil {
+					response.Fatal(rsp, errors.Wrapf(err, "cannot set extra resources requirement from %s", obj.GetName()))
+					return rsp, nil
+				}
+			case "Context":
+				if err := setContext(rsp, obj); err != nil {
+					response.Fatal(rsp, errors.Wrapf(err, "cannot set context from %s", obj.GetName()))
+					return rsp, nil
+				}
 			default:
 				response.Fatal(rsp, fmt.Errorf(errFmtInvalidMetaType, obj.GetKind()))
 				return rsp, nil
@@ -212,6 +238,86 @@ func (f *Function) RunFunction(_ context.Context, req *fnv1beta1.RunFunctionRequ
 	return rsp, nil
 }
 
+// buildTemplateContext builds the map that templates are executed against.
+// Rather than forcing templates to navigate the raw protojson request (e.g.
+// `.observed.resources.<name>.resource`) it exposes a curated view of
+// observed and desired state, with connection details auto-decoded from
+// base64. The raw request map is still available under "raw" for backward
+// compatibility.
+func buildTemplateContext(req *fnv1beta1.RunFunctionRequest) (map[string]interface{}, error) {
+	raw, err := convertToMap(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot convert request to map")
+	}
+
+	oxr, err := request.GetObservedCompositeResource(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get observed composite resource")
+	}
+
+	ocds, err := request.GetObservedComposedResources(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get observed composed resources")
+	}
+
+	observedComposed := make(map[string]interface{}, len(ocds))
+	for name, ocd := range ocds {
+		observedComposed[string(name)] = map[string]interface{}{
+			"resource":          ocd.Resource.UnstructuredContent(),
+			"connectionDetails": decodeConnectionDetails(ocd.ConnectionDetails),
+		}
+	}
+
+	dxr, err := request.GetDesiredCompositeResource(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get desired composite resource")
+	}
+
+	dcds, err := request.GetDesiredComposedResources(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get desired composed resources")
+	}
+
+	desiredComposed := make(map[string]interface{}, len(dcds))
+	for name, dcd := range dcds {
+		desiredComposed[string(name)] = dcd.Resource.UnstructuredContent()
+	}
+
+	ctx := map[string]interface{}{}
+	if s := req.GetContext(); s != nil {
+		ctx = s.AsMap()
+	}
+
+	reqMap := map[string]interface{}{
+		"observed": map[string]interface{}{
+			"composite": map[string]interface{}{
+				"resource":          oxr.Resource.UnstructuredContent(),
+				"connectionDetails": decodeConnectionDetails(oxr.ConnectionDetails),
+			},
+			"composed": observedComposed,
+		},
+		"desired": map[string]interface{}{
+			"composite": dxr.Resource.UnstructuredContent(),
+			"resources": desiredComposed,
+		},
+		"context": ctx,
+		"raw":     raw,
+	}
+
+	return withExtraResources(reqMap, req), nil
+}
+
+// decodeConnectionDetails renders connection detail values as plain strings
+// so templates don't have to base64-decode secret data themselves.
+func decodeConnectionDetails(cd map[string][]byte) map[string]string {
+	out := make(map[string]string, len(cd))
+	for k, v := range cd {
+		out[k] = string(v)
+	}
+
+	return out
+}
+
 func convertToMap(req *fnv1beta1.RunFunctionRequest) (map[string]interface{}, error) {
 	jReq, err := protojson.Marshal(req)
 	if err != nil {
@@ -226,6 +332,186 @@ func convertToMap(req *fnv1beta1.RunFunctionRequest) (map[string]interface{}, er
 	return mReq, nil
 }
 
+// setResult turns a rendered meta Result object into a Normal, Warning, or
+// Fatal entry in rsp.Results, per the severity the template set. It reports
+// fatal as true when the caller must stop processing further objects and
+// return rsp immediately, matching every other response.Fatal call site in
+// this file.
+func setResult(rsp *fnv1beta1.RunFunctionResponse, obj *unstructured.Unstructured) (fatal bool, err error) {
+	paved := fieldpath.Pave(obj.Object)
+
+	severity, err := paved.GetString("severity")
+	if err != nil {
+		return false, errors.Wrap(err, "cannot get result severity")
+	}
+
+	message, err := paved.GetString("message")
+	if err != nil {
+		return false, errors.Wrap(err, "cannot get result message")
+	}
+
+	switch severity {
+	case "Normal":
+		response.Normal(rsp, message)
+	case "Warning":
+		response.Warning(rsp, errors.New(message))
+	case "Fatal":
+		response.Fatal(rsp, errors.New(message))
+		return true, nil
+	default:
+		return false, errors.Errorf(errFmtInvalidSeverity, severity)
+	}
+
+	return false, nil
+}
+
+// setExtraResourcesRequirement turns a rendered meta ExtraResources object
+// into a set of named resource selectors in rsp.Requirements.ExtraResources.
+// Crossplane will re-invoke the function with the matching resources present
+// under req.ExtraResources.
+func setExtraResourcesRequirement(rsp *fnv1beta1.RunFunctionResponse, obj *unstructured.Unstructured) error {
+	resources, found, err := unstructured.NestedSlice(obj.Object, "spec", "resources")
+	if err != nil {
+		return errors.Wrap(err, "cannot get spec.resources")
+	}
+	if !found {
+		return errors.New("spec.resources is required")
+	}
+
+	if rsp.Requirements == nil {
+		rsp.Requirements = &fnv1beta1.Requirements{}
+	}
+	if rsp.Requirements.ExtraResources == nil {
+		rsp.Requirements.ExtraResources = make(map[string]*fnv1beta1.ResourceSelector)
+	}
+
+	for _, r := range resources {
+		res, ok := r.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("spec.resources entry %v is not an object", r)
+		}
+
+		name, _ := res["name"].(string)
+		if name == "" {
+			return errors.New("spec.resources entry is missing a name")
+		}
+
+		apiVersion, _ := res["apiVersion"].(string)
+		if apiVersion == "" {
+			return errors.Errorf("resource %q is missing an apiVersion", name)
+		}
+
+		kind, _ := res["kind"].(string)
+		if kind == "" {
+			return errors.Errorf("resource %q is missing a kind", name)
+		}
+
+		sel := &fnv1beta1.ResourceSelector{
+			ApiVersion: apiVersion,
+			Kind:       kind,
+		}
+
+		switch {
+		case res["matchName"] != nil:
+			sel.Match = &fnv1beta1.ResourceSelector_MatchName{MatchName: fmt.Sprintf("%v", res["matchName"])}
+		case res["matchLabels"] != nil:
+			ml, ok := res["matchLabels"].(map[string]interface{})
+			if !ok {
+				return errors.Errorf("resource %q matchLabels is not an object", name)
+			}
+
+			labels := make(map[string]string, len(ml))
+			for k, v := range ml {
+				labels[k] = fmt.Sprintf("%v", v)
+			}
+
+			sel.Match = &fnv1beta1.ResourceSelector_MatchLabels{MatchLabels: &fnv1beta1.MatchLabels{Labels: labels}}
+		default:
+			return errors.Errorf("resource %q must set matchName or matchLabels", name)
+		}
+
+		rsp.Requirements.ExtraResources[name] = sel
+	}
+
+	return nil
+}
+
+// setContext merges a rendered meta Context object's data into rsp.Context,
+// so it's available to later functions in the same pipeline (for example to
+// contribute to the Composition Environment). Where a key's existing and new
+// values are both objects they're merged recursively, so a template only has
+// to set the keys it cares about without clobbering keys a previous Function
+// already contributed.
+func setContext(rsp *fnv1beta1.RunFunctionResponse, obj *unstructured.Unstructured) error {
+	data, found, err := unstructured.NestedMap(obj.Object, "data")
+	if err != nil {
+		return errors.Wrap(err, "cannot get context data")
+	}
+	if !found {
+		return errors.New("data is required")
+	}
+
+	for k, v := range data {
+		if existing := rsp.GetContext().GetFields()[k].GetStructValue(); existing != nil {
+			if m, ok := v.(map[string]interface{}); ok {
+				v = mergeContextValues(existing.AsMap(), m)
+			}
+		}
+
+		val, err := structpb.NewValue(v)
+		if err != nil {
+			return errors.Wrapf(err, "cannot convert context value %q", k)
+		}
+
+		response.SetContextKey(rsp, k, val)
+	}
+
+	return nil
+}
+
+// mergeContextValues recursively merges src into a copy of dst, so that only
+// the keys present in src are added or overwritten.
+func mergeContextValues(dst, src map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(dst))
+	for k, v := range dst {
+		out[k] = v
+	}
+
+	for k, v := range src {
+		if existingMap, ok := out[k].(map[string]interface{}); ok {
+			if newMap, ok := v.(map[string]interface{}); ok {
+				out[k] = mergeContextValues(existingMap, newMap)
+				continue
+			}
+		}
+
+		out[k] = v
+	}
+
+	return out
+}
+
+// withExtraResources exposes any extra resources Crossplane fetched for us on
+// a previous call under reqMap["extraResources"][name].items, so templates
+// can branch on cluster state that was requested via a meta ExtraResources
+// object.
+func withExtraResources(reqMap map[string]interface{}, req *fnv1beta1.RunFunctionRequest) map[string]interface{} {
+	extra := make(map[string]interface{}, len(req.GetExtraResources()))
+
+	for name, res := range req.GetExtraResources() {
+		items := make([]interface{}, 0, len(res.GetItems()))
+		for _, item := range res.GetItems() {
+			items = append(items, item.GetResource().AsMap())
+		}
+
+		extra[name] = map[string]interface{}{"items": items}
+	}
+
+	reqMap["extraResources"] = extra
+
+	return reqMap
+}
+
 func getCompositionResourceName(obj *unstructured.Unstructured) (resource.Name, error) {
 	if v, found := obj.GetAnnotations()[annotationKeyCompositionResourceName]; found {
 		return resource.Name(v), nil
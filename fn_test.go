@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fnv1beta1 "github.com/crossplane/function-sdk-go/proto/v1beta1"
+)
+
+func TestSetResult(t *testing.T) {
+	cases := map[string]struct {
+		severity  string
+		wantFatal bool
+		wantErr   bool
+	}{
+		"Normal":  {severity: "Normal"},
+		"Warning": {severity: "Warning"},
+		"Fatal":   {severity: "Fatal", wantFatal: true},
+		"Invalid": {severity: "Bogus", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"severity": tc.severity,
+				"message":  "hello",
+			}}
+
+			rsp := &fnv1beta1.RunFunctionResponse{}
+
+			fatal, err := setResult(rsp, obj)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("setResult(...): expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("setResult(...): unexpected error: %v", err)
+			}
+
+			if fatal != tc.wantFatal {
+				t.Errorf("setResult(...) fatal = %v, want %v", fatal, tc.wantFatal)
+			}
+
+			if len(rsp.Results) != 1 {
+				t.Fatalf("setResult(...): rsp.Results has %d entries, want 1", len(rsp.Results))
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+)
+
+// GetNewTemplateWithFunctionMaps returns a new, unparsed *template.Template
+// named after the entrypoint template, pre-populated with sprig's function
+// map.
+func GetNewTemplateWithFunctionMaps() *template.Template {
+	return template.New(entrypointTemplateName).Funcs(sprig.TxtFuncMap())
+}
@@ -0,0 +1,195 @@
+// Package v1beta1 contains the input type for this Function.
+// +kubebuilder:object:generate=true
+// +groupName=gotemplating.fn.crossplane.io
+// +versionName=v1beta1
+package v1beta1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TemplateSourceType defines where the Function should load templates from.
+type TemplateSourceType string
+
+const (
+	// InlineTemplateSourceType indicates that Templates are defined inline.
+	InlineTemplateSourceType TemplateSourceType = "Inline"
+	// FileSystemTemplateSourceType indicates that templates are loaded from a filesystem path.
+	FileSystemTemplateSourceType TemplateSourceType = "FileSystem"
+	// DirectoryTemplateSourceType indicates that templates are loaded from every
+	// file matching a glob under a directory.
+	DirectoryTemplateSourceType TemplateSourceType = "Directory"
+	// ConfigMapTemplateSourceType indicates that templates are loaded from the
+	// keys of a ConfigMap.
+	ConfigMapTemplateSourceType TemplateSourceType = "ConfigMap"
+	// OCITemplateSourceType indicates that templates are loaded from the files
+	// of an OCI artifact.
+	OCITemplateSourceType TemplateSourceType = "OCI"
+)
+
+// This isn't a custom resource, in the sense that we never install its CRD.
+// It is a KRM-like object, so we generate a deepcopy method for it.
+// +kubebuilder:object:root=true
+
+// Input can be used to provide templates for rendering composed resources.
+type Input struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Source of the templates.
+	// +kubebuilder:validation:Enum=Inline;FileSystem
+	// +kubebuilder:default=Inline
+	Source TemplateSourceType `json:"source"`
+
+	// Inline templates.
+	// +optional
+	Inline *InlineSource `json:"inline,omitempty"`
+
+	// FileSystemPath to load templates from.
+	// +optional
+	FileSystemPath string `json:"fileSystemPath,omitempty"`
+
+	// Validation configures schema-aware validation of rendered manifests.
+	// +optional
+	Validation *Validation `json:"validation,omitempty"`
+
+	// Sources is a list of named template sources that are all loaded into
+	// the same template, so one can include another with
+	// {{ template "name" . }}. The template named "main" is the entrypoint
+	// that's actually executed. Set this instead of Source/Inline/
+	// FileSystemPath to split templates across multiple sources.
+	// +optional
+	Sources []TemplateSource `json:"sources,omitempty"`
+}
+
+// InlineSource is an inline source of templates.
+type InlineSource struct {
+	// Template of the resource(s) this Function will render.
+	Template string `json:"template"`
+}
+
+// TemplateSource is one of potentially many sources that together make up
+// the templates this Function renders.
+type TemplateSource struct {
+	// Type of this template source. ConfigMap and OCI are not yet
+	// implemented; using them is a Fatal error.
+	// +kubebuilder:validation:Enum=Inline;FileSystem;Directory;ConfigMap;OCI
+	Type TemplateSourceType `json:"type"`
+
+	// Name this source's template is known by, so other templates can
+	// include it with {{ template "name" . }}. Defaults to "main". Ignored
+	// by Directory and ConfigMap sources, which derive a name per file/key.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Inline is used when Type is Inline.
+	// +optional
+	Inline *InlineSource `json:"inline,omitempty"`
+
+	// FileSystemPath is used when Type is FileSystem, and loads a single
+	// template file.
+	// +optional
+	FileSystemPath string `json:"fileSystemPath,omitempty"`
+
+	// DirectoryPath is used when Type is Directory, and loads every file
+	// matching Glob as a template named after the file (without extension).
+	// +optional
+	DirectoryPath string `json:"directoryPath,omitempty"`
+
+	// Glob restricts which files within DirectoryPath are loaded. Defaults
+	// to "*.yaml".
+	// +optional
+	Glob string `json:"glob,omitempty"`
+
+	// ConfigMapRef is used when Type is ConfigMap. Each key of the
+	// referenced ConfigMap becomes a template named after that key. Loading
+	// from a ConfigMap is not implemented: a source of Type ConfigMap is a
+	// Fatal error, and the field is ignored on sources of any other type.
+	// +optional
+	ConfigMapRef *TemplateConfigMapRef `json:"configMapRef,omitempty"`
+
+	// OCI is used when Type is OCI. Loading from OCI is not implemented: a
+	// source of Type OCI is a Fatal error, and the field is ignored on
+	// sources of any other type.
+	// +optional
+	OCI *OCISource `json:"oci,omitempty"`
+}
+
+// TemplateConfigMapRef references a ConfigMap whose keys are loaded as
+// templates.
+type TemplateConfigMapRef struct {
+	// Name of the referenced ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the referenced ConfigMap.
+	Namespace string `json:"namespace"`
+}
+
+// OCISource references an OCI artifact whose files are loaded as templates.
+type OCISource struct {
+	// Image is the OCI reference to pull, e.g. registry.example.org/templates:v1.
+	Image string `json:"image"`
+}
+
+// ValidationMode controls what happens when a rendered manifest fails
+// schema validation.
+type ValidationMode string
+
+const (
+	// ValidationModeWarn surfaces schema violations as Warning results and
+	// still composes the resource. This is the default.
+	ValidationModeWarn ValidationMode = "warn"
+	// ValidationModeStrict surfaces schema violations as Fatal results,
+	// aborting the Composition.
+	ValidationModeStrict ValidationMode = "strict"
+)
+
+// Validation configures schema-aware validation of rendered manifests
+// before they're composed.
+type Validation struct {
+	// Mode controls the severity of schema violations. Defaults to "warn".
+	// +kubebuilder:validation:Enum=warn;strict
+	// +kubebuilder:default=warn
+	Mode ValidationMode `json:"mode,omitempty"`
+
+	// Schemas are the CRD/OpenAPI schemas rendered manifests are validated
+	// against, keyed by the GVK they apply to.
+	Schemas []ValidationSchema `json:"schemas,omitempty"`
+}
+
+// ValidationSchema is the schema used to validate rendered manifests of a
+// given GVK.
+type ValidationSchema struct {
+	// APIVersion of the manifests this schema validates.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the manifests this schema validates.
+	Kind string `json:"kind"`
+
+	// OpenAPIV3Schema is used when the schema is provided inline.
+	// +optional
+	OpenAPIV3Schema *apiextensionsv1.JSONSchemaProps `json:"openAPIV3Schema,omitempty"`
+
+	// ConfigMapRef loads the schema from a ConfigMap key. Not yet
+	// implemented; setting it is a Fatal error.
+	// +optional
+	ConfigMapRef *ValidationSchemaSourceRef `json:"configMapRef,omitempty"`
+
+	// SecretRef loads the schema from a Secret key. Not yet implemented;
+	// setting it is a Fatal error.
+	// +optional
+	SecretRef *ValidationSchemaSourceRef `json:"secretRef,omitempty"`
+}
+
+// ValidationSchemaSourceRef references a key within a ConfigMap or Secret
+// that holds a schema.
+type ValidationSchemaSourceRef struct {
+	// Name of the referenced resource.
+	Name string `json:"name"`
+
+	// Namespace of the referenced resource.
+	Namespace string `json:"namespace"`
+
+	// Key within the referenced resource's data that holds the schema.
+	Key string `json:"key"`
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/function-go-templating/input/v1beta1"
+)
+
+// entrypointTemplateName is the name of the template that's actually
+// executed. Every other named template is only reachable via
+// {{ template "name" . }} from this one (or from each other).
+const entrypointTemplateName = "main"
+
+const errFmtSourceUnsupported = "%s template sources aren't supported yet"
+
+// TemplateSourceGetter loads one or more named templates from the sources
+// configured in the Function input.
+type TemplateSourceGetter struct {
+	in *v1beta1.Input
+}
+
+// NewTemplateSourceGetter returns a TemplateSourceGetter for the supplied
+// Function input.
+func NewTemplateSourceGetter(in *v1beta1.Input) (*TemplateSourceGetter, error) {
+	if len(in.Sources) == 0 && in.Source == "" {
+		return nil, errors.New("source or sources must be set")
+	}
+
+	return &TemplateSourceGetter{in: in}, nil
+}
+
+// LoadInto parses every configured template source into tmpl, each under its
+// own name, so that any template can include another with
+// {{ template "name" . }}. RunFunction then executes the template named
+// entrypointTemplateName.
+func (g *TemplateSourceGetter) LoadInto(tmpl *template.Template) (*template.Template, error) {
+	for _, s := range g.sources() {
+		named, err := g.load(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot load template source %q", sourceName(s))
+		}
+
+		for name, content := range named {
+			if tmpl, err = tmpl.Parse(wrapDefine(name, content)); err != nil {
+				return nil, errors.Wrapf(err, "cannot parse template %q", name)
+			}
+		}
+	}
+
+	return tmpl, nil
+}
+
+// sources normalizes the legacy, single-source Source/Inline/FileSystemPath
+// fields into the same shape as Sources, so callers only have to handle one
+// case.
+func (g *TemplateSourceGetter) sources() []v1beta1.TemplateSource {
+	if len(g.in.Sources) > 0 {
+		return g.in.Sources
+	}
+
+	return []v1beta1.TemplateSource{{
+		Type:           g.in.Source,
+		Name:           entrypointTemplateName,
+		Inline:         g.in.Inline,
+		FileSystemPath: g.in.FileSystemPath,
+	}}
+}
+
+// load returns the named templates a single source produces, keyed by name.
+func (g *TemplateSourceGetter) load(s v1beta1.TemplateSource) (map[string]string, error) {
+	switch s.Type {
+	case v1beta1.InlineTemplateSourceType:
+		if s.Inline == nil {
+			return nil, errors.New("inline source is missing its template")
+		}
+
+		return map[string]string{sourceName(s): s.Inline.Template}, nil
+
+	case v1beta1.FileSystemTemplateSourceType:
+		if s.FileSystemPath == "" {
+			return nil, errors.New("fileSystem source is missing its fileSystemPath")
+		}
+
+		content, err := os.ReadFile(s.FileSystemPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read %s", s.FileSystemPath)
+		}
+
+		return map[string]string{sourceName(s): string(content)}, nil
+
+	case v1beta1.DirectoryTemplateSourceType:
+		return g.loadDirectory(s)
+
+	case v1beta1.ConfigMapTemplateSourceType:
+		return nil, errors.Errorf(errFmtSourceUnsupported, "ConfigMap")
+
+	case v1beta1.OCITemplateSourceType:
+		return nil, errors.Errorf(errFmtSourceUnsupported, "OCI")
+
+	default:
+		return nil, errors.Errorf("unknown template source type %q", s.Type)
+	}
+}
+
+// loadDirectory loads every file matching s.Glob under s.DirectoryPath,
+// naming each template after its file (without extension).
+func (g *TemplateSourceGetter) loadDirectory(s v1beta1.TemplateSource) (map[string]string, error) {
+	if s.DirectoryPath == "" {
+		return nil, errors.New("directory source is missing its directoryPath")
+	}
+
+	glob := s.Glob
+	if glob == "" {
+		glob = "*.yaml"
+	}
+
+	matches, err := filepath.Glob(filepath.Join(s.DirectoryPath, glob))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot glob %s", filepath.Join(s.DirectoryPath, glob))
+	}
+
+	named := make(map[string]string, len(matches))
+
+	for _, m := range matches {
+		content, err := os.ReadFile(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read %s", m)
+		}
+
+		name := strings.TrimSuffix(filepath.Base(m), filepath.Ext(m))
+		named[name] = string(content)
+	}
+
+	return named, nil
+}
+
+// sourceName returns the name a source's template should be known by,
+// defaulting to the entrypoint name.
+func sourceName(s v1beta1.TemplateSource) string {
+	if s.Name == "" {
+		return entrypointTemplateName
+	}
+
+	return s.Name
+}
+
+// wrapDefine wraps raw template content in a {{ define }} block, so it can be
+// invoked by name from any other template parsed into the same
+// *template.Template.
+func wrapDefine(name, content string) string {
+	return `{{- define "` + name + `" }}` + "\n" + content + "\n" + `{{- end }}`
+}
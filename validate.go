@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	fnv1beta1 "github.com/crossplane/function-sdk-go/proto/v1beta1"
+	"github.com/crossplane/function-sdk-go/response"
+
+	"github.com/crossplane-contrib/function-go-templating/input/v1beta1"
+)
+
+const errFmtSchemaRefUnsupported = "validation schema for %s is loaded via %s, which isn't supported yet"
+
+// validateRenderedObjects checks every rendered object that isn't a meta
+// object against the schema configured for its GVK, if any. Schema
+// violations become Warning results, or Fatal ones when in.Validation.Mode
+// is "strict", rather than silently producing a desired state that would
+// only fail at apply time.
+func validateRenderedObjects(rsp *fnv1beta1.RunFunctionResponse, in *v1beta1.Input, objs []*unstructured.Unstructured) error {
+	if in.Validation == nil || len(in.Validation.Schemas) == 0 {
+		return nil
+	}
+
+	validators, err := newSchemaValidators(in.Validation.Schemas)
+	if err != nil {
+		return errors.Wrap(err, "cannot build schema validators")
+	}
+
+	strict := in.Validation.Mode == v1beta1.ValidationModeStrict
+
+	for _, obj := range objs {
+		if obj.GetAPIVersion() == metaApiVersion {
+			continue
+		}
+
+		v, ok := validators[obj.GroupVersionKind()]
+		if !ok {
+			continue
+		}
+
+		errs := validation.ValidateCustomResource(nil, obj.Object, v)
+		for _, e := range errs {
+			msg := fmt.Sprintf("%s %q is invalid: %s", obj.GetKind(), obj.GetName(), e.Error())
+
+			if strict {
+				return errors.New(msg)
+			}
+
+			response.Warning(rsp, errors.New(msg))
+		}
+	}
+
+	return nil
+}
+
+// newSchemaValidators builds a validator per GVK from the inline schemas
+// configured in the Function input. Loading a schema from a ConfigMap or
+// Secret reference, or from a discovery client when running in-cluster, is
+// not yet implemented; configuring one is a Fatal error rather than a
+// silent no-op.
+func newSchemaValidators(schemas []v1beta1.ValidationSchema) (map[schema.GroupVersionKind]validation.SchemaValidator, error) {
+	out := make(map[schema.GroupVersionKind]validation.SchemaValidator, len(schemas))
+
+	for _, s := range schemas {
+		switch {
+		case s.ConfigMapRef != nil:
+			return nil, errors.Errorf(errFmtSchemaRefUnsupported, s.Kind, "a ConfigMap reference")
+		case s.SecretRef != nil:
+			return nil, errors.Errorf(errFmtSchemaRefUnsupported, s.Kind, "a Secret reference")
+		case s.OpenAPIV3Schema == nil:
+			return nil, errors.Errorf("schema for %s has neither openAPIV3Schema nor a configMapRef/secretRef", s.Kind)
+		}
+
+		internal := &apiextensions.JSONSchemaProps{}
+		if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(s.OpenAPIV3Schema, internal, nil); err != nil {
+			return nil, errors.Wrapf(err, "cannot convert schema for %s", s.Kind)
+		}
+
+		v, _, err := validation.NewSchemaValidator(internal)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot build schema validator for %s", s.Kind)
+		}
+
+		gv, err := schema.ParseGroupVersion(s.APIVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse apiVersion %q", s.APIVersion)
+		}
+
+		out[gv.WithKind(s.Kind)] = v
+	}
+
+	return out, nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fnv1beta1 "github.com/crossplane/function-sdk-go/proto/v1beta1"
+
+	"github.com/crossplane-contrib/function-go-templating/input/v1beta1"
+)
+
+// widgetSchema requires a string "size" property and rejects any property
+// it doesn't know about.
+func widgetSchema() v1beta1.ValidationSchema {
+	return v1beta1.ValidationSchema{
+		APIVersion: "example.org/v1",
+		Kind:       "Widget",
+		OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+			Type:     "object",
+			Required: []string{"spec"},
+			Properties: map[string]apiextensionsv1.JSONSchemaProps{
+				"spec": {
+					Type:                 "object",
+					Required:             []string{"size"},
+					AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false},
+					Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"size": {Type: "string"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func widget(spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.org/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "test-widget"},
+		"spec":       spec,
+	}}
+}
+
+func TestValidateRenderedObjects(t *testing.T) {
+	cases := map[string]struct {
+		spec      map[string]interface{}
+		wantValid bool
+	}{
+		"Valid": {
+			spec:      map[string]interface{}{"size": "large"},
+			wantValid: true,
+		},
+		"MissingRequiredField": {
+			spec: map[string]interface{}{},
+		},
+		"TypeMismatch": {
+			spec: map[string]interface{}{"size": 42},
+		},
+		"UnknownField": {
+			spec: map[string]interface{}{"size": "large", "color": "red"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := &v1beta1.Input{
+				Validation: &v1beta1.Validation{
+					Mode:    v1beta1.ValidationModeWarn,
+					Schemas: []v1beta1.ValidationSchema{widgetSchema()},
+				},
+			}
+
+			rsp := &fnv1beta1.RunFunctionResponse{}
+
+			if err := validateRenderedObjects(rsp, in, []*unstructured.Unstructured{widget(tc.spec)}); err != nil {
+				t.Fatalf("validateRenderedObjects(...): unexpected error: %v", err)
+			}
+
+			gotValid := len(rsp.Results) == 0
+			if gotValid != tc.wantValid {
+				t.Errorf("validateRenderedObjects(...) produced %d warning(s), want valid=%v", len(rsp.Results), tc.wantValid)
+			}
+		})
+	}
+}
+
+func TestValidateRenderedObjectsStrictMode(t *testing.T) {
+	in := &v1beta1.Input{
+		Validation: &v1beta1.Validation{
+			Mode:    v1beta1.ValidationModeStrict,
+			Schemas: []v1beta1.ValidationSchema{widgetSchema()},
+		},
+	}
+
+	rsp := &fnv1beta1.RunFunctionResponse{}
+
+	err := validateRenderedObjects(rsp, in, []*unstructured.Unstructured{widget(map[string]interface{}{})})
+	if err == nil {
+		t.Fatalf("validateRenderedObjects(...): expected an error for an invalid object in strict mode, got none")
+	}
+}
+
+func TestNewSchemaValidatorsUnsupportedSources(t *testing.T) {
+	cases := map[string]v1beta1.ValidationSchema{
+		"ConfigMapRef": {
+			APIVersion:   "example.org/v1",
+			Kind:         "Widget",
+			ConfigMapRef: &v1beta1.ValidationSchemaSourceRef{Name: "widget-schema"},
+		},
+		"SecretRef": {
+			APIVersion: "example.org/v1",
+			Kind:       "Widget",
+			SecretRef:  &v1beta1.ValidationSchemaSourceRef{Name: "widget-schema"},
+		},
+	}
+
+	for name, s := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newSchemaValidators([]v1beta1.ValidationSchema{s}); err == nil {
+				t.Fatalf("newSchemaValidators(...): expected an error, got none")
+			}
+		})
+	}
+}